@@ -0,0 +1,287 @@
+// Package api exposes hound's HTTP surface: the search/repos/excludes/stats
+// endpoints (see Setup) and, in this file, the webhook receiver that lets a
+// git host push updates to hound instead of waiting for the poll interval.
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hound-search/hound/config"
+	"github.com/hound-search/hound/searcher"
+)
+
+// webhookPayload is the subset of fields hound needs out of a push event,
+// common across the providers below once each has parsed its own JSON shape.
+type webhookPayload struct {
+	repoFullName string
+	cloneUrls    []string
+}
+
+// provider identifies which host sent a delivery, so we know which header
+// carries the signature and how to parse the body.
+type provider string
+
+const (
+	providerGitHub    provider = "github"
+	providerGitLab    provider = "gitlab"
+	providerGitea     provider = "gitea"
+	providerBitbucket provider = "bitbucket"
+)
+
+// updater is the part of *searcher.Searcher that handleWebhook needs. It's
+// declared here, rather than depending on the concrete type directly, so
+// the handler can be exercised in tests with a fake.
+type updater interface {
+	Update()
+}
+
+// SetupWebhooks registers the webhook receiver at /webhook/{repo...} on mux.
+// The {repo} path segment(s) must match a key in cfg.Repos; the matching
+// repo must have EnablePushUpdates and a push-config secret set, or the
+// delivery is rejected. On a verified, matching delivery, the corresponding
+// searcher.Searcher's Update is triggered so the repo is re-indexed
+// immediately instead of waiting for the next poll.
+//
+// The route uses the {repo...} wildcard, not {repo}, because auto-discovered
+// repos (e.g. vcs.DiscoverGiteaRepos) are keyed by their host's full_name,
+// which contains a slash (e.g. "acme/widgets") -- a single-segment wildcard
+// would 404 on exactly those repos.
+func SetupWebhooks(mux *http.ServeMux, searchers map[string]*searcher.Searcher, cfg *config.Config) {
+	updaters := make(map[string]updater, len(searchers))
+	for name, s := range searchers {
+		updaters[name] = s
+	}
+
+	mux.HandleFunc("/webhook/{repo...}", func(w http.ResponseWriter, r *http.Request) {
+		handleWebhook(w, r, updaters, cfg)
+	})
+}
+
+func handleWebhook(
+	w http.ResponseWriter,
+	r *http.Request,
+	searchers map[string]updater,
+	cfg *config.Config) {
+	name := r.PathValue("repo")
+
+	repo, ok := cfg.Repos[name]
+	if !ok {
+		log.Printf("webhook: rejected delivery for unknown repo %q", name)
+		http.NotFound(w, r)
+		return
+	}
+
+	if !repo.PushUpdatesEnabled() {
+		log.Printf("webhook: rejected delivery for %q: push updates not enabled", name)
+		http.Error(w, "push updates not enabled for this repo", http.StatusForbidden)
+		return
+	}
+
+	secret := repo.WebhookSecret()
+	if secret == "" {
+		log.Printf("webhook: rejected delivery for %q: no push secret configured", name)
+		http.Error(w, "no push secret configured for this repo", http.StatusForbidden)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("webhook: failed to read delivery body for %q: %v", name, err)
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	p, err := detectProvider(r.Header)
+	if err != nil {
+		log.Printf("webhook: rejected delivery for %q: %v", name, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !verifySignature(p, secret, body, r.Header) {
+		log.Printf("webhook: rejected delivery for %q: %s signature mismatch", name, p)
+		http.Error(w, "signature mismatch", http.StatusUnauthorized)
+		return
+	}
+
+	payload, err := parsePayload(p, body)
+	if err != nil {
+		log.Printf("webhook: rejected delivery for %q: %v", name, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !payloadMatchesRepo(payload, repo) {
+		log.Printf("webhook: rejected delivery for %q: payload does not reference this repo", name)
+		http.Error(w, "payload does not reference this repo", http.StatusBadRequest)
+		return
+	}
+
+	s, ok := searchers[name]
+	if !ok {
+		log.Printf("webhook: accepted delivery for %q but no searcher is running for it", name)
+		http.Error(w, "repo is not indexed", http.StatusServiceUnavailable)
+		return
+	}
+
+	log.Printf("webhook: accepted %s delivery for %q, triggering update", p, name)
+	s.Update()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// detectProvider identifies the sending host from the headers it's known to
+// set on every delivery.
+func detectProvider(h http.Header) (provider, error) {
+	switch {
+	case h.Get("X-Hub-Signature-256") != "":
+		return providerGitHub, nil
+	case h.Get("X-Gitlab-Token") != "":
+		return providerGitLab, nil
+	case h.Get("X-Gitea-Signature") != "":
+		return providerGitea, nil
+	case h.Get("X-Hub-Signature") != "" || h.Get("X-Event-Key") != "":
+		return providerBitbucket, nil
+	default:
+		return "", fmt.Errorf("unrecognized webhook delivery: no known signature header present")
+	}
+}
+
+// verifySignature checks the delivery's signature/token header against the
+// repo's configured secret. All comparisons are constant-time.
+func verifySignature(p provider, secret string, body []byte, h http.Header) bool {
+	switch p {
+	case providerGitHub:
+		return verifyHmacHexPrefixed(secret, body, h.Get("X-Hub-Signature-256"), "sha256=", sha256.New)
+	case providerGitea:
+		return verifyHmacHex(secret, body, h.Get("X-Gitea-Signature"), sha256.New)
+	case providerGitLab:
+		return hmac.Equal([]byte(h.Get("X-Gitlab-Token")), []byte(secret))
+	case providerBitbucket:
+		return verifyHmacHexPrefixed(secret, body, h.Get("X-Hub-Signature"), "sha256=", sha256.New)
+	default:
+		return false
+	}
+}
+
+func verifyHmacHexPrefixed(secret string, body []byte, header, prefix string, hashNew func() hash.Hash) bool {
+	if !strings.HasPrefix(header, prefix) {
+		return false
+	}
+	return verifyHmacHex(secret, body, strings.TrimPrefix(header, prefix), hashNew)
+}
+
+func verifyHmacHex(secret string, body []byte, gotHex string, hashNew func() hash.Hash) bool {
+	mac := hmac.New(hashNew, []byte(secret))
+	mac.Write(body)
+	want := mac.Sum(nil)
+
+	got, err := hex.DecodeString(gotHex)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(got, want)
+}
+
+// parsePayload extracts the repo identity from a push event body. Each
+// provider ships a different shape; we only need enough of it to confirm
+// the delivery is for the repo the URL claims it's for.
+func parsePayload(p provider, body []byte) (*webhookPayload, error) {
+	switch p {
+	case providerGitHub, providerGitea:
+		var v struct {
+			Repository struct {
+				FullName string `json:"full_name"`
+				CloneUrl string `json:"clone_url"`
+				SshUrl   string `json:"ssh_url"`
+			} `json:"repository"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, fmt.Errorf("invalid %s payload: %w", p, err)
+		}
+		return &webhookPayload{
+			repoFullName: v.Repository.FullName,
+			cloneUrls:    []string{v.Repository.CloneUrl, v.Repository.SshUrl},
+		}, nil
+	case providerGitLab:
+		var v struct {
+			Project struct {
+				PathWithNamespace string `json:"path_with_namespace"`
+				GitHttpUrl        string `json:"git_http_url"`
+				GitSshUrl         string `json:"git_ssh_url"`
+			} `json:"project"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, fmt.Errorf("invalid gitlab payload: %w", err)
+		}
+		return &webhookPayload{
+			repoFullName: v.Project.PathWithNamespace,
+			cloneUrls:    []string{v.Project.GitHttpUrl, v.Project.GitSshUrl},
+		}, nil
+	case providerBitbucket:
+		var v struct {
+			Repository struct {
+				FullName string `json:"full_name"`
+				Links    struct {
+					Clone []struct {
+						Href string `json:"href"`
+					} `json:"clone"`
+				} `json:"links"`
+			} `json:"repository"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, fmt.Errorf("invalid bitbucket payload: %w", err)
+		}
+		urls := make([]string, 0, len(v.Repository.Links.Clone))
+		for _, c := range v.Repository.Links.Clone {
+			urls = append(urls, c.Href)
+		}
+		return &webhookPayload{
+			repoFullName: v.Repository.FullName,
+			cloneUrls:    urls,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported provider %q", p)
+	}
+}
+
+// payloadMatchesRepo confirms the delivery actually describes the repo that
+// the {repo...} path value resolved to, so a leaked secret for one repo can't
+// be replayed to force a re-index of an unrelated one.
+func payloadMatchesRepo(p *webhookPayload, repo *config.Repo) bool {
+	for _, cloneUrl := range p.cloneUrls {
+		if cloneUrl == "" {
+			continue
+		}
+		if urlsEquivalent(cloneUrl, repo.Url) {
+			return true
+		}
+	}
+
+	return p.repoFullName != "" && strings.Contains(repo.Url, p.repoFullName)
+}
+
+// urlsEquivalent compares two clone URLs ignoring case, a trailing ".git"
+// and credentials embedded in a scheme://user@host URL (token-embedded vs
+// not). The scheme is not normalised: an https URL does not match the ssh
+// form of the same repo.
+func urlsEquivalent(a, b string) bool {
+	norm := func(s string) string {
+		s = strings.TrimSuffix(s, ".git")
+		if i := strings.Index(s, "@"); i != -1 && strings.Contains(s, "://") {
+			scheme := s[:strings.Index(s, "://")+3]
+			s = scheme + s[i+1:]
+		}
+		return strings.ToLower(s)
+	}
+	return norm(a) == norm(b)
+}
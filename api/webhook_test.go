@@ -0,0 +1,376 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hound-search/hound/config"
+	"github.com/hound-search/hound/searcher"
+)
+
+type fakeUpdater struct {
+	updated bool
+}
+
+func (u *fakeUpdater) Update() { u.updated = true }
+
+func hmacHex(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Captured (trimmed) samples of the push payloads each provider actually
+// sends, just enough for parsePayload to pull the repo identity out of.
+const (
+	githubPushPayload = `{
+		"ref": "refs/heads/main",
+		"repository": {
+			"full_name": "acme/widgets",
+			"clone_url": "https://github.com/acme/widgets.git",
+			"ssh_url": "git@github.com:acme/widgets.git"
+		}
+	}`
+
+	giteaPushPayload = `{
+		"ref": "refs/heads/main",
+		"repository": {
+			"full_name": "acme/widgets",
+			"clone_url": "https://gitea.example.com/acme/widgets.git",
+			"ssh_url": "git@gitea.example.com:acme/widgets.git"
+		}
+	}`
+
+	gitlabPushPayload = `{
+		"object_kind": "push",
+		"project": {
+			"path_with_namespace": "acme/widgets",
+			"git_http_url": "https://gitlab.example.com/acme/widgets.git",
+			"git_ssh_url": "git@gitlab.example.com:acme/widgets.git"
+		}
+	}`
+
+	bitbucketPushPayload = `{
+		"push": {"changes": []},
+		"repository": {
+			"full_name": "acme/widgets",
+			"links": {
+				"clone": [
+					{"name": "https", "href": "https://bitbucket.org/acme/widgets.git"},
+					{"name": "ssh", "href": "git@bitbucket.org:acme/widgets.git"}
+				]
+			}
+		}
+	}`
+)
+
+func TestDetectProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    provider
+		wantErr bool
+	}{
+		{"github", map[string]string{"X-Hub-Signature-256": "sha256=abc"}, providerGitHub, false},
+		{"gitlab", map[string]string{"X-Gitlab-Token": "tok"}, providerGitLab, false},
+		{"gitea", map[string]string{"X-Gitea-Signature": "abc"}, providerGitea, false},
+		{"bitbucket signature", map[string]string{"X-Hub-Signature": "sha256=abc"}, providerBitbucket, false},
+		{"bitbucket event key", map[string]string{"X-Event-Key": "repo:push"}, providerBitbucket, false},
+		{"unrecognized", map[string]string{"X-Random-Header": "abc"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+
+			got, err := detectProvider(h)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("detectProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("detectProvider() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "s3cr3t"
+	const body = `{"hello":"world"}`
+
+	tests := []struct {
+		name    string
+		p       provider
+		headers map[string]string
+		want    bool
+	}{
+		{
+			name:    "github valid",
+			p:       providerGitHub,
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + hmacHex(secret, body)},
+			want:    true,
+		},
+		{
+			name:    "github invalid",
+			p:       providerGitHub,
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + hmacHex("wrong", body)},
+			want:    false,
+		},
+		{
+			name:    "github missing prefix",
+			p:       providerGitHub,
+			headers: map[string]string{"X-Hub-Signature-256": hmacHex(secret, body)},
+			want:    false,
+		},
+		{
+			name:    "gitea valid",
+			p:       providerGitea,
+			headers: map[string]string{"X-Gitea-Signature": hmacHex(secret, body)},
+			want:    true,
+		},
+		{
+			name:    "gitea invalid",
+			p:       providerGitea,
+			headers: map[string]string{"X-Gitea-Signature": hmacHex("wrong", body)},
+			want:    false,
+		},
+		{
+			name:    "gitlab valid",
+			p:       providerGitLab,
+			headers: map[string]string{"X-Gitlab-Token": secret},
+			want:    true,
+		},
+		{
+			name:    "gitlab invalid",
+			p:       providerGitLab,
+			headers: map[string]string{"X-Gitlab-Token": "wrong"},
+			want:    false,
+		},
+		{
+			name:    "bitbucket valid",
+			p:       providerBitbucket,
+			headers: map[string]string{"X-Hub-Signature": "sha256=" + hmacHex(secret, body)},
+			want:    true,
+		},
+		{
+			name:    "bitbucket invalid",
+			p:       providerBitbucket,
+			headers: map[string]string{"X-Hub-Signature": "sha256=" + hmacHex("wrong", body)},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+
+			if got := verifySignature(tt.p, secret, []byte(body), h); got != tt.want {
+				t.Errorf("verifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        provider
+		body     string
+		wantFull string
+		wantErr  bool
+	}{
+		{"github", providerGitHub, githubPushPayload, "acme/widgets", false},
+		{"gitea", providerGitea, giteaPushPayload, "acme/widgets", false},
+		{"gitlab", providerGitLab, gitlabPushPayload, "acme/widgets", false},
+		{"bitbucket", providerBitbucket, bitbucketPushPayload, "acme/widgets", false},
+		{"malformed json", providerGitHub, `{"repository":`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePayload(tt.p, []byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.repoFullName != tt.wantFull {
+				t.Errorf("repoFullName = %q, want %q", got.repoFullName, tt.wantFull)
+			}
+			if len(got.cloneUrls) == 0 {
+				t.Errorf("expected at least one clone url")
+			}
+		})
+	}
+}
+
+func TestPayloadMatchesRepo(t *testing.T) {
+	repo := &config.Repo{Url: "https://github.com/acme/widgets.git"}
+
+	match, err := parsePayload(providerGitHub, []byte(githubPushPayload))
+	if err != nil {
+		t.Fatalf("parsePayload() error = %v", err)
+	}
+	if !payloadMatchesRepo(match, repo) {
+		t.Errorf("expected matching payload to match repo %q", repo.Url)
+	}
+
+	other := &config.Repo{Url: "https://github.com/acme/other.git"}
+	if payloadMatchesRepo(match, other) {
+		t.Errorf("expected payload for widgets to not match repo %q", other.Url)
+	}
+}
+
+// TestWebhookRouteMatchesMultiSegmentRepoNames guards against regressing to
+// a single-segment {repo} wildcard: auto-discovered repos (e.g. Gitea's
+// org/repo full_name) are keyed by names containing a slash, so the route
+// must use {repo...} to capture them.
+func TestWebhookRouteMatchesMultiSegmentRepoNames(t *testing.T) {
+	// Push updates are left disabled, so a request that reaches the handler
+	// as "acme/widgets" is rejected with 403; a route that did not match the
+	// two-segment path would answer 404 instead.
+	cfg := &config.Config{Repos: map[string]*config.Repo{
+		"acme/widgets": {Url: "https://github.com/acme/widgets.git"},
+	}}
+	mux := http.NewServeMux()
+	SetupWebhooks(mux, map[string]*searcher.Searcher{}, cfg)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/acme/widgets", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "push updates not enabled") {
+		t.Errorf("body = %q, want the push-updates rejection for %q", w.Body.String(), "acme/widgets")
+	}
+}
+
+func TestHandleWebhook(t *testing.T) {
+	const secret = "s3cr3t"
+
+	baseRepo := func() *config.Repo {
+		enabled := true
+		return &config.Repo{
+			Url:               "https://github.com/acme/widgets.git",
+			EnablePushUpdates: &enabled,
+			PushConfig:        &config.PushConfig{Secret: secret},
+		}
+	}
+
+	sign := func(body string) string {
+		return "sha256=" + hmacHex(secret, body)
+	}
+
+	newRequest := func(repoName, body, signature string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/webhook/"+repoName, strings.NewReader(body))
+		r.SetPathValue("repo", repoName)
+		r.Header.Set("X-Hub-Signature-256", signature)
+		return r
+	}
+
+	t.Run("accepted delivery triggers update", func(t *testing.T) {
+		cfg := &config.Config{Repos: map[string]*config.Repo{"widgets": baseRepo()}}
+		fake := &fakeUpdater{}
+		searchers := map[string]updater{"widgets": fake}
+
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign(githubPushPayload)), searchers, cfg)
+
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusNoContent, w.Body.String())
+		}
+		if !fake.updated {
+			t.Errorf("expected Update() to be called")
+		}
+	})
+
+	t.Run("accepted delivery for a multi-segment (org/repo) name triggers update", func(t *testing.T) {
+		cfg := &config.Config{Repos: map[string]*config.Repo{"acme/widgets": baseRepo()}}
+		fake := &fakeUpdater{}
+		searchers := map[string]updater{"acme/widgets": fake}
+
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("acme/widgets", githubPushPayload, sign(githubPushPayload)), searchers, cfg)
+
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusNoContent, w.Body.String())
+		}
+		if !fake.updated {
+			t.Errorf("expected Update() to be called")
+		}
+	})
+
+	t.Run("unknown repo is rejected", func(t *testing.T) {
+		cfg := &config.Config{Repos: map[string]*config.Repo{}}
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign(githubPushPayload)), nil, cfg)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("push updates disabled is rejected", func(t *testing.T) {
+		repo := baseRepo()
+		disabled := false
+		repo.EnablePushUpdates = &disabled
+		cfg := &config.Config{Repos: map[string]*config.Repo{"widgets": repo}}
+
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign(githubPushPayload)), nil, cfg)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("missing secret is rejected", func(t *testing.T) {
+		repo := baseRepo()
+		repo.PushConfig = nil
+		cfg := &config.Config{Repos: map[string]*config.Repo{"widgets": repo}}
+
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign(githubPushPayload)), nil, cfg)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("bad signature is rejected", func(t *testing.T) {
+		cfg := &config.Config{Repos: map[string]*config.Repo{"widgets": baseRepo()}}
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign("tampered body")), nil, cfg)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("mismatched repo is rejected", func(t *testing.T) {
+		repo := baseRepo()
+		repo.Url = "https://github.com/acme/unrelated.git"
+		cfg := &config.Config{Repos: map[string]*config.Repo{"widgets": repo}}
+
+		w := httptest.NewRecorder()
+		handleWebhook(w, newRequest("widgets", githubPushPayload, sign(githubPushPayload)), nil, cfg)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+		}
+	})
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/hound-search/hound/api"
 	"github.com/hound-search/hound/config"
+	"github.com/hound-search/hound/grpcapi"
 	"github.com/hound-search/hound/searcher"
 	"github.com/hound-search/hound/ui"
+	"github.com/hound-search/hound/vcs"
 	"github.com/hound-search/hound/web"
 )
 
@@ -31,6 +33,25 @@ var (
 	basepath   = filepath.Dir(b)
 )
 
+// discoverRepos auto-discovers repos from the configured Gitea instance and
+// merges them into cfg.Repos so they get indexed alongside any repos that
+// were hand-listed in the config file. A configured GitLab instance is not
+// discovered here.
+func discoverRepos(cfg *config.Config) error {
+	if cfg.GiteaInstance != nil {
+		repos, err := vcs.DiscoverGiteaRepos(cfg.GiteaInstance)
+		if err != nil {
+			return err
+		}
+
+		for name, repo := range repos {
+			cfg.AddDiscoveredRepo(name, repo)
+		}
+	}
+
+	return nil
+}
+
 func makeSearchers(cfg *config.Config) (map[string]*searcher.Searcher, bool, error) {
 	// Ensure we have a dbpath
 	if _, err := os.Stat(cfg.DbPath); err != nil {
@@ -112,9 +133,18 @@ func runHttp( //nolint
 
 	m.Handle("/", h)
 	api.Setup(m, idx, cfg.ResultLimit)
+	api.SetupWebhooks(m, idx, cfg)
 	return http.ListenAndServe(addr, m)
 }
 
+// runGrpc serves the gRPC hound service on addr, alongside the HTTP server
+// started in main. It blocks while serving and returns an error if the
+// listener cannot be opened or serving fails; callers should run it on its
+// own goroutine.
+func runGrpc(addr string, cfg *config.Config, idx map[string]*searcher.Searcher) error {
+	return grpcapi.ListenAndServe(addr, idx, cfg.ResultLimit)
+}
+
 // TODO: Automatically increment this when building a release
 func getVersion() semver.Version {
 	return semver.Version{
@@ -131,6 +161,7 @@ func main() {
 
 	flagConf := flag.String("conf", "config.json", "")
 	flagAddr := flag.String("addr", ":6080", "")
+	flagGrpcAddr := flag.String("grpc-addr", "", "Address to serve the gRPC api on, e.g. :6081. Overrides grpc_addr in the config; gRPC is disabled if both are empty.")
 	flagDev := flag.Bool("dev", false, "")
 	flagVer := flag.Bool("version", false, "Display version and exit")
 
@@ -146,6 +177,10 @@ func main() {
 		panic(err)
 	}
 
+	if err := discoverRepos(&cfg); err != nil {
+		log.Panic(err)
+	}
+
 	// Start the web server on a background routine.
 	ws := web.Start(&cfg, *flagAddr, *flagDev)
 
@@ -164,6 +199,19 @@ func main() {
 
 	handleShutdown(shutdownCh, searchers)
 
+	grpcAddr := *flagGrpcAddr
+	if grpcAddr == "" {
+		grpcAddr = cfg.GrpcAddr
+	}
+	if grpcAddr != "" {
+		go func() {
+			info_log.Printf("running gRPC server at %s\n", grpcAddr)
+			if err := runGrpc(grpcAddr, &cfg, searchers); err != nil {
+				error_log.Printf("gRPC server stopped: %v", err)
+			}
+		}()
+	}
+
 	host := *flagAddr
 	if strings.HasPrefix(host, ":") { //nolint
 		host = "localhost" + host
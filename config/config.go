@@ -36,6 +36,45 @@ type Repo struct {
 	EnablePollUpdates  *bool          `json:"enable-poll-updates"`
 	EnablePushUpdates  *bool          `json:"enable-push-updates"`
 	AutoGeneratedFiles []string       `json:"auto-generated-files"`
+	PushConfig         *PushConfig    `json:"push-config"`
+}
+
+// PushConfig holds the shared secret used to authenticate incoming webhook
+// deliveries for a repo that has push updates enabled. Set either Secret
+// (inline, for local/dev configs) or SecretEnv (the name of an environment
+// variable holding the secret, for anything checked into version control).
+//
+// Repo, and therefore PushConfig, is marshalled wholesale into the web UI's
+// bootstrap data (see makeTemplateData in cmds/houndd/main.go), so Secret
+// must never round-trip through MarshalJSON the way VcsConfigMessage
+// doesn't (see SecretMessage below) -- MarshalJSON below drops it.
+type PushConfig struct {
+	Secret    string `json:"secret"`
+	SecretEnv string `json:"secret-env"`
+}
+
+// MarshalJSON redacts Secret so it never ends up in the UI's bootstrap data
+// or any other JSON encoding of a Repo. SecretEnv is just an environment
+// variable name, not a secret itself, so it's safe to keep.
+func (p *PushConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		SecretEnv string `json:"secret-env"`
+	}{p.SecretEnv})
+}
+
+// WebhookSecret resolves the configured push secret for this repo. It
+// returns an empty string if the repo has no push config, in which case
+// webhook deliveries for it should be rejected.
+func (r *Repo) WebhookSecret() string {
+	if r.PushConfig == nil {
+		return ""
+	}
+
+	if r.PushConfig.Secret != "" {
+		return r.PushConfig.Secret
+	}
+
+	return os.Getenv(r.PushConfig.SecretEnv)
 }
 
 // Used for interpreting the config value for fields that use *bool. If a value
@@ -62,6 +101,13 @@ type GitLabInstance struct {
 	TokenENV string `json:"token_env"`
 }
 
+type GiteaInstance struct {
+	Url      string   `json:"url"`
+	TokenENV string   `json:"token_env"`
+	Orgs     []string `json:"orgs"`
+	Users    []string `json:"users"`
+}
+
 type Config struct {
 	DbPath                string                    `json:"dbpath"`
 	Title                 string                    `json:"title"`
@@ -71,6 +117,8 @@ type Config struct {
 	VCSConfigMessages     map[string]*SecretMessage `json:"vcs-config"`
 	ResultLimit           int                       `json:"result-limit"`
 	GitLabInstance        *GitLabInstance           `json:"gitlab_instance"`
+	GiteaInstance         *GiteaInstance            `json:"gitea_instance"`
+	GrpcAddr              string                    `json:"grpc_addr"`
 }
 
 // SecretMessage is just like json.RawMessage but it will not
@@ -101,6 +149,23 @@ func (r *Repo) VcsConfig() []byte {
 	return *r.VcsConfigMessage
 }
 
+// AddDiscoveredRepo registers a repo found by auto-discovery (e.g. the
+// Gitea instance integration), applying the same defaults
+// LoadFromFile applies to hand-written repos. Repos already present under
+// name are left untouched, so a hand-written config entry always wins.
+func (c *Config) AddDiscoveredRepo(name string, r *Repo) {
+	if c.Repos == nil {
+		c.Repos = map[string]*Repo{}
+	}
+
+	if _, exists := c.Repos[name]; exists {
+		return
+	}
+
+	initRepo(r)
+	c.Repos[name] = r
+}
+
 // Populate missing config values with default values.
 func initRepo(r *Repo) {
 	if r.MsBetweenPolls == 0 {
@@ -0,0 +1,193 @@
+// Package grpcapi serves the same search/repos/excludes/stats operations as
+// the HTTP api package, over gRPC, for programmatic consumers (CI bots,
+// editor plugins) that want to skip JSON overhead. SearchStream sends a
+// repo's matches to the client as soon as that repo's search completes,
+// rather than waiting for every requested repo to finish -- it does not
+// (yet) stream within a single repo's search, since searcher.Searcher only
+// exposes the buffering Search method.
+//
+// The message/service types come from proto/hound/v1/hound.proto; the
+// generated hound.pb.go/hound_grpc.pb.go live alongside it under
+// proto/hound/v1 and are checked in. Run `go generate ./...` (requires the
+// buf CLI plus protoc-gen-go/protoc-gen-go-grpc on PATH) to regenerate them
+// after editing the .proto.
+package grpcapi
+
+//go:generate sh -c "cd ../proto && buf generate"
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+
+	houndv1 "github.com/hound-search/hound/proto/hound/v1"
+	"github.com/hound-search/hound/searcher"
+)
+
+// server implements houndv1.HoundServiceServer against a live set of
+// searchers, the same map[string]*searcher.Searcher that api.Setup uses.
+type server struct {
+	houndv1.UnimplementedHoundServiceServer
+
+	searchers map[string]*searcher.Searcher
+	limit     int
+}
+
+// Setup registers the gRPC hound service on s, backed by searchers and
+// honoring resultLimit the same way the HTTP api package does.
+func Setup(s *grpc.Server, searchers map[string]*searcher.Searcher, resultLimit int) {
+	houndv1.RegisterHoundServiceServer(s, &server{
+		searchers: searchers,
+		limit:     resultLimit,
+	})
+}
+
+// ListenAndServe starts a gRPC server with the hound service registered on
+// addr. It blocks until the listener fails or the server is stopped.
+func ListenAndServe(addr string, searchers map[string]*searcher.Searcher, resultLimit int) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("grpcapi: failed to listen on %s: %w", addr, err)
+	}
+
+	s := grpc.NewServer()
+	Setup(s, searchers, resultLimit)
+	return s.Serve(lis)
+}
+
+func (s *server) repoNames(req []string) []string {
+	if len(req) > 0 {
+		return req
+	}
+
+	names := make([]string, 0, len(s.searchers))
+	for name := range s.searchers {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (s *server) maxMatches(req int32) int {
+	if req > 0 {
+		return int(req)
+	}
+	return s.limit
+}
+
+func (s *server) Search(ctx context.Context, req *houndv1.SearchRequest) (*houndv1.SearchResponse, error) {
+	resp := &houndv1.SearchResponse{}
+
+	for _, name := range s.repoNames(req.Repos) {
+		srch, ok := s.searchers[name]
+		if !ok {
+			resp.Results = append(resp.Results, &houndv1.RepoResult{
+				Repo:  name,
+				Error: fmt.Sprintf("unknown repo %q", name),
+			})
+			continue
+		}
+
+		matches, err := toFileMatches(srch, req, s.maxMatches(req.MaxMatches))
+		if err != nil {
+			resp.Results = append(resp.Results, &houndv1.RepoResult{Repo: name, Error: err.Error()})
+			continue
+		}
+
+		resp.Results = append(resp.Results, &houndv1.RepoResult{Repo: name, Matches: matches})
+	}
+
+	return resp, nil
+}
+
+// SearchStream runs the same search as Search, but sends each repo's
+// matches to the client as soon as that repo's search returns, instead of
+// waiting for every repo in the request to finish before responding.
+func (s *server) SearchStream(req *houndv1.SearchRequest, stream houndv1.HoundService_SearchStreamServer) error {
+	for _, name := range s.repoNames(req.Repos) {
+		srch, ok := s.searchers[name]
+		if !ok {
+			continue
+		}
+
+		matches, err := toFileMatches(srch, req, s.maxMatches(req.MaxMatches))
+		if err != nil {
+			return err
+		}
+
+		for _, m := range matches {
+			if err := stream.Send(&houndv1.SearchStreamChunk{Repo: name, Match: m}); err != nil {
+				return err
+			}
+		}
+
+		if err := stream.Send(&houndv1.SearchStreamChunk{Repo: name, RepoDone: true}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *server) Repos(ctx context.Context, req *houndv1.ReposRequest) (*houndv1.ReposResponse, error) {
+	repos := make(map[string]string, len(s.searchers))
+	for name, srch := range s.searchers {
+		repos[name] = srch.Url()
+	}
+	return &houndv1.ReposResponse{Repos: repos}, nil
+}
+
+func (s *server) Excludes(ctx context.Context, req *houndv1.ExcludesRequest) (*houndv1.ExcludesResponse, error) {
+	srch, ok := s.searchers[req.Repo]
+	if !ok {
+		return nil, fmt.Errorf("unknown repo %q", req.Repo)
+	}
+	return &houndv1.ExcludesResponse{Excludes: srch.Excludes()}, nil
+}
+
+func (s *server) Stats(ctx context.Context, req *houndv1.StatsRequest) (*houndv1.StatsResponse, error) {
+	srch, ok := s.searchers[req.Repo]
+	if !ok {
+		return nil, fmt.Errorf("unknown repo %q", req.Repo)
+	}
+
+	filesIndexed, lastIndexUnix := srch.Stats()
+	return &houndv1.StatsResponse{
+		FilesIndexed:         filesIndexed,
+		LastIndexUnixSeconds: lastIndexUnix,
+	}, nil
+}
+
+// toFileMatches runs req's query against a single repo's index and converts
+// the full, buffered result into the proto FileMatch shape, capped at
+// maxMatches. Both Search and SearchStream use it, so SearchStream also
+// gets a repo's matches only once that repo's search has returned.
+func toFileMatches(srch *searcher.Searcher, req *houndv1.SearchRequest, maxMatches int) ([]*houndv1.FileMatch, error) {
+	results, err := srch.Search(req.Query, req.IgnoreCase, req.FilesWithMatch, int(req.LinesOfContext), maxMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*houndv1.FileMatch, 0, len(results))
+	for _, r := range results {
+		out = append(out, toFileMatch(r))
+	}
+
+	return out, nil
+}
+
+// toFileMatch converts a single searcher.FileResult into the proto
+// FileMatch shape.
+func toFileMatch(r searcher.FileResult) *houndv1.FileMatch {
+	fm := &houndv1.FileMatch{Filename: r.Filename}
+	for _, m := range r.Matches {
+		fm.Matches = append(fm.Matches, &houndv1.Match{
+			LineNumber: int32(m.LineNumber),
+			Line:       m.Line,
+			Before:     m.Before,
+			After:      m.After,
+		})
+	}
+	return fm
+}
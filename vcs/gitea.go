@@ -0,0 +1,201 @@
+// Package vcs contains drivers for discovering and authenticating against
+// self-hosted source control instances. This file implements discovery for
+// Gitea, mirroring the GitLab discovery flow: it walks the Gitea REST API,
+// paginating through the results, and turns what it finds into the
+// map[string]*config.Repo shape that hound's indexer expects.
+package vcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hound-search/hound/config"
+)
+
+// giteaPageSize is the page size requested from the Gitea API. Gitea caps
+// this at 50 regardless of what is requested.
+const giteaPageSize = 50
+
+// giteaRepo is the subset of the Gitea API repository representation that
+// hound needs in order to index a repo.
+type giteaRepo struct {
+	FullName string `json:"full_name"`
+	CloneUrl string `json:"clone_url"`
+	Private  bool   `json:"private"`
+}
+
+// giteaClient talks to a single Gitea instance's REST API on behalf of the
+// configured GiteaInstance.
+type giteaClient struct {
+	baseUrl string
+	token   string
+	http    *http.Client
+}
+
+func newGiteaClient(inst *config.GiteaInstance) *giteaClient {
+	return &giteaClient{
+		baseUrl: strings.TrimRight(inst.Url, "/"),
+		token:   os.Getenv(inst.TokenENV),
+		http:    http.DefaultClient,
+	}
+}
+
+// get fetches a single page from the given Gitea API path and decodes the
+// JSON array response into repos.
+func (c *giteaClient) get(path string, query url.Values, page int) ([]giteaRepo, error) {
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(giteaPageSize))
+
+	reqUrl := fmt.Sprintf("%s/api/v1%s?%s", c.baseUrl, path, query.Encode())
+	req, err := http.NewRequest("GET", reqUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gitea: %s returned %s", reqUrl, res.Status)
+	}
+
+	var repos []giteaRepo
+	if err := json.NewDecoder(res.Body).Decode(&repos); err != nil {
+		return nil, err
+	}
+
+	return repos, nil
+}
+
+// paginate repeatedly calls fetch for each page until a short (or empty)
+// page is returned, accumulating every repo seen along the way.
+func (c *giteaClient) paginate(fetch func(page int) ([]giteaRepo, error)) ([]giteaRepo, error) {
+	var all []giteaRepo
+	for page := 1; ; page++ {
+		repos, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, repos...)
+		if len(repos) < giteaPageSize {
+			return all, nil
+		}
+	}
+}
+
+// SearchRepos returns every repo on the instance matching the given query
+// string, via GET /api/v1/repos/search.
+func (c *giteaClient) SearchRepos(query string) ([]giteaRepo, error) {
+	return c.paginate(func(page int) ([]giteaRepo, error) {
+		return c.get("/repos/search", url.Values{"q": {query}}, page)
+	})
+}
+
+// OrgRepos returns every repo belonging to the given organization, via
+// GET /api/v1/orgs/{org}/repos.
+func (c *giteaClient) OrgRepos(org string) ([]giteaRepo, error) {
+	return c.paginate(func(page int) ([]giteaRepo, error) {
+		return c.get(fmt.Sprintf("/orgs/%s/repos", url.PathEscape(org)), url.Values{}, page)
+	})
+}
+
+// UserRepos returns every repo owned by the given user, via
+// GET /api/v1/users/{user}/repos.
+func (c *giteaClient) UserRepos(user string) ([]giteaRepo, error) {
+	return c.paginate(func(page int) ([]giteaRepo, error) {
+		return c.get(fmt.Sprintf("/users/%s/repos", url.PathEscape(user)), url.Values{}, page)
+	})
+}
+
+// authCloneUrl rewrites a Gitea clone URL to embed the instance token, the
+// same way the git VCS driver authenticates clones/polls for any other
+// token-based host.
+func (c *giteaClient) authCloneUrl(cloneUrl string) (string, error) {
+	if c.token == "" {
+		return cloneUrl, nil
+	}
+
+	u, err := url.Parse(cloneUrl)
+	if err != nil {
+		return "", err
+	}
+
+	u.User = url.UserPassword("oauth2", c.token)
+	return u.String(), nil
+}
+
+// DiscoverGiteaRepos queries the configured Gitea instance for every repo
+// it can see and returns them as config.Repo entries, keyed the same way
+// cfg.Repos is, ready to be merged into cfg.Repos on startup. Private repos
+// get their token embedded in Url so the git driver can clone and poll them
+// like any other authenticated remote.
+//
+// If inst.Orgs or inst.Users is set, discovery is limited to the repos of
+// those orgs/users. Otherwise every repo visible to the token is indexed.
+func DiscoverGiteaRepos(inst *config.GiteaInstance) (map[string]*config.Repo, error) {
+	c := newGiteaClient(inst)
+
+	seen := map[string]giteaRepo{}
+	addAll := func(repos []giteaRepo) {
+		for _, r := range repos {
+			seen[r.FullName] = r
+		}
+	}
+
+	if len(inst.Orgs) == 0 && len(inst.Users) == 0 {
+		repos, err := c.SearchRepos("")
+		if err != nil {
+			return nil, err
+		}
+		addAll(repos)
+	}
+
+	for _, org := range inst.Orgs {
+		repos, err := c.OrgRepos(org)
+		if err != nil {
+			return nil, err
+		}
+		addAll(repos)
+	}
+
+	for _, user := range inst.Users {
+		repos, err := c.UserRepos(user)
+		if err != nil {
+			return nil, err
+		}
+		addAll(repos)
+	}
+
+	out := make(map[string]*config.Repo, len(seen))
+	for name, r := range seen {
+		cloneUrl := r.CloneUrl
+		if r.Private {
+			authed, err := c.authCloneUrl(cloneUrl)
+			if err != nil {
+				return nil, err
+			}
+			cloneUrl = authed
+		}
+
+		out[name] = &config.Repo{
+			Url:         cloneUrl,
+			Vcs:         "git",
+			DisplayName: name,
+		}
+	}
+
+	return out, nil
+}